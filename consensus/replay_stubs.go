@@ -29,6 +29,9 @@ func (txmp emptyMempool) RemoveTxByKey(txKey types.TxKey) error {
 
 func (emptyMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return types.Txs{} }
 func (emptyMempool) ReapMaxTxs(n int) types.Txs              { return types.Txs{} }
+func (emptyMempool) ReapMaxNamespaceBytes(_ []byte, _ int64) types.Txs {
+	return types.Txs{}
+}
 func (emptyMempool) Update(
 	_ int64,
 	_ types.Txs,
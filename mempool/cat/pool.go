@@ -445,6 +445,31 @@ func (txmp *TxPool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	return keep
 }
 
+// ReapMaxNamespaceBytes returns a slice of valid blob transactions that
+// contain a blob in the given namespace and fit within the size constraint.
+// The results are ordered by nonincreasing priority, with ties broken by
+// increasing order of arrival. Reaping transactions does not remove them from
+// the mempool.
+//
+// If maxBytes < 0, no limit is set on the total size in bytes.
+func (txmp *TxPool) ReapMaxNamespaceBytes(namespace []byte, maxBytes int64) types.Txs {
+	var totalBytes int64
+
+	var keep []types.Tx //nolint:prealloc
+	for _, w := range txmp.allEntriesSorted() {
+		if !mempool.HasNamespace(w.namespaces, namespace) {
+			continue
+		}
+		txBytes := types.ComputeProtoSizeForTxs([]types.Tx{w.tx})
+		if maxBytes >= 0 && totalBytes+txBytes > maxBytes {
+			continue
+		}
+		totalBytes += txBytes
+		keep = append(keep, w.tx)
+	}
+	return keep
+}
+
 // ReapMaxTxs returns up to max transactions from the mempool. The results are
 // ordered by nonincreasing priority with ties broken by increasing order of
 // arrival. Reaping transactions does not remove them from the mempool.
@@ -3,6 +3,7 @@ package cat
 import (
 	"time"
 
+	"github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -11,24 +12,26 @@ import (
 // seen this transaction, this struct should never be modified
 type wrappedTx struct {
 	// these fields are immutable
-	tx        types.Tx    // the original transaction data
-	key       types.TxKey // the transaction hash
-	height    int64       // height when this transaction was initially checked (for expiry)
-	timestamp time.Time   // time when transaction was entered (for TTL)
-	gasWanted int64       // app: gas required to execute this transaction
-	priority  int64       // app: priority value for this transaction
-	sender    string      // app: assigned sender label
+	tx         types.Tx    // the original transaction data
+	key        types.TxKey // the transaction hash
+	height     int64       // height when this transaction was initially checked (for expiry)
+	timestamp  time.Time   // time when transaction was entered (for TTL)
+	gasWanted  int64       // app: gas required to execute this transaction
+	priority   int64       // app: priority value for this transaction
+	sender     string      // app: assigned sender label
+	namespaces [][]byte    // namespaces of the blobs in tx, nil if tx is not a BlobTx
 }
 
 func newWrappedTx(tx types.Tx, key types.TxKey, height, gasWanted, priority int64, sender string) *wrappedTx {
 	return &wrappedTx{
-		tx:        tx,
-		key:       key,
-		height:    height,
-		timestamp: time.Now().UTC(),
-		gasWanted: gasWanted,
-		priority:  priority,
-		sender:    sender,
+		tx:         tx,
+		key:        key,
+		height:     height,
+		timestamp:  time.Now().UTC(),
+		gasWanted:  gasWanted,
+		priority:   priority,
+		sender:     sender,
+		namespaces: mempool.TxNamespaces(tx),
 	}
 }
 
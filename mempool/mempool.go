@@ -49,6 +49,16 @@ type Mempool interface {
 	// (~ all available transactions).
 	ReapMaxTxs(max int) types.Txs
 
+	// ReapMaxNamespaceBytes reaps blob transactions from the mempool that
+	// contain at least one blob in the given namespace, up to maxBytes bytes
+	// total. The namespace is the namespace version byte followed by the
+	// namespace ID (see TxNamespaces). Transactions that are not a BlobTx are
+	// never returned.
+	//
+	// If maxBytes is negative, there is no cap on the size of all returned
+	// transactions (~ all available transactions in the namespace).
+	ReapMaxNamespaceBytes(namespace []byte, maxBytes int64) types.Txs
+
 	// Lock locks the mempool. The consensus must be able to hold lock to safely
 	// update.
 	Lock()
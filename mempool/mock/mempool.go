@@ -21,6 +21,9 @@ func (Mempool) CheckTx(_ types.Tx, _ func(*abci.Response), _ mempool.TxInfo) err
 func (Mempool) RemoveTxByKey(txKey types.TxKey) error   { return nil }
 func (Mempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return types.Txs{} }
 func (Mempool) ReapMaxTxs(n int) types.Txs              { return types.Txs{} }
+func (Mempool) ReapMaxNamespaceBytes(_ []byte, _ int64) types.Txs {
+	return types.Txs{}
+}
 func (Mempool) Update(
 	_ int64,
 	_ types.Txs,
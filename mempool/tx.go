@@ -1,7 +1,11 @@
 package mempool
 
 import (
+	"bytes"
+	"math"
+
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
 )
 
 // TxInfo are parameters that get passed when attempting to add a tx to the
@@ -15,3 +19,37 @@ type TxInfo struct {
 	// SenderP2PID is the actual p2p.ID of the sender, used e.g. for logging.
 	SenderP2PID p2p.ID
 }
+
+// TxNamespaces returns the namespaces of the blobs contained in tx. Each
+// namespace is the namespace version byte followed by the namespace ID. It
+// returns nil if tx is not a BlobTx. Blobs whose namespace version does not
+// fit in a byte are skipped and duplicate namespaces are only returned once.
+func TxNamespaces(tx types.Tx) [][]byte {
+	blobTx, isBlobTx := types.UnmarshalBlobTx(tx)
+	if !isBlobTx {
+		return nil
+	}
+	namespaces := make([][]byte, 0, len(blobTx.Blobs))
+	for _, blob := range blobTx.Blobs {
+		if blob.NamespaceVersion > math.MaxUint8 {
+			continue
+		}
+		//nolint:gosec
+		namespace := append([]byte{uint8(blob.NamespaceVersion)}, blob.NamespaceId...)
+		if HasNamespace(namespaces, namespace) {
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces
+}
+
+// HasNamespace reports whether namespace is one of namespaces.
+func HasNamespace(namespaces [][]byte, namespace []byte) bool {
+	for _, ns := range namespaces {
+		if bytes.Equal(ns, namespace) {
+			return true
+		}
+	}
+	return false
+}
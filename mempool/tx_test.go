@@ -0,0 +1,37 @@
+package mempool
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/pkg/consts"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTxNamespaces(t *testing.T) {
+	namespaceOne := bytes.Repeat([]byte{1}, consts.NamespaceIDSize)
+	namespaceTwo := bytes.Repeat([]byte{2}, consts.NamespaceIDSize)
+
+	bTx, err := types.MarshalBlobTx(
+		[]byte{1, 2, 3, 4},
+		&tmproto.Blob{NamespaceId: namespaceOne, Data: []byte{1}},
+		&tmproto.Blob{NamespaceId: namespaceTwo, Data: []byte{2}, NamespaceVersion: 1},
+		&tmproto.Blob{NamespaceId: namespaceOne, Data: []byte{3}},
+		&tmproto.Blob{NamespaceId: namespaceTwo, Data: []byte{4}, NamespaceVersion: math.MaxUint8 + 1},
+	)
+	require.NoError(t, err)
+
+	namespaces := TxNamespaces(bTx)
+	require.Equal(t, [][]byte{
+		append([]byte{0}, namespaceOne...),
+		append([]byte{1}, namespaceTwo...),
+	}, namespaces)
+	require.True(t, HasNamespace(namespaces, append([]byte{1}, namespaceTwo...)))
+	require.False(t, HasNamespace(namespaces, append([]byte{0}, namespaceTwo...)))
+
+	require.Nil(t, TxNamespaces(types.Tx("sender=key=value")))
+}
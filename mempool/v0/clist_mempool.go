@@ -427,9 +427,10 @@ func (mem *CListMempool) resCbFirstTime(
 			}
 
 			memTx := &mempoolTx{
-				height:    mem.height,
-				gasWanted: r.CheckTx.GasWanted,
-				tx:        tx,
+				height:     mem.height,
+				gasWanted:  r.CheckTx.GasWanted,
+				tx:         tx,
+				namespaces: mempool.TxNamespaces(tx),
 			}
 			memTx.senders.Store(peerID, true)
 			mem.addTx(memTx)
@@ -610,6 +611,33 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	return txs
 }
 
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) ReapMaxNamespaceBytes(namespace []byte, maxBytes int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	var runningSize int64
+
+	txs := make([]types.Tx, 0)
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if !mempool.HasNamespace(memTx.namespaces, namespace) {
+			continue
+		}
+
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			return txs
+		}
+
+		runningSize += dataSize
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
 // Lock() must be help by the caller during execution.
 func (mem *CListMempool) Update(
 	height int64,
@@ -704,6 +732,9 @@ type mempoolTx struct {
 	gasWanted int64    // amount of gas this tx states it will require
 	tx        types.Tx //
 
+	// namespaces of the blobs in tx, nil if tx is not a BlobTx
+	namespaces [][]byte
+
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
 	senders sync.Map
@@ -799,6 +799,40 @@ func TestRemoveBlobTx(t *testing.T) {
 	assert.EqualValues(t, 0, mp.SizeBytes())
 }
 
+func TestReapMaxNamespaceBytes(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	namespaceOne := bytes.Repeat([]byte{1}, consts.NamespaceIDSize)
+	namespaceTwo := bytes.Repeat([]byte{2}, consts.NamespaceIDSize)
+	newBlobTx := func(originalTx, namespaceID []byte) types.Tx {
+		bTx, err := types.MarshalBlobTx(originalTx, &tmproto.Blob{
+			NamespaceId: namespaceID,
+			Data:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		})
+		require.NoError(t, err)
+		return bTx
+	}
+
+	txOne := newBlobTx([]byte{1}, namespaceOne)
+	txTwo := newBlobTx([]byte{2}, namespaceTwo)
+	txThree := newBlobTx([]byte{3}, namespaceOne)
+	for _, tx := range []types.Tx{txOne, txTwo, txThree, types.Tx("sender=key=value")} {
+		require.NoError(t, mp.CheckTx(tx, nil, mempool.TxInfo{}))
+	}
+
+	nsOne := append([]byte{0}, namespaceOne...)
+	require.Equal(t, types.Txs{txOne, txThree}, mp.ReapMaxNamespaceBytes(nsOne, -1))
+	require.Equal(t, types.Txs{txOne}, mp.ReapMaxNamespaceBytes(nsOne, types.ComputeProtoSizeForTxs([]types.Tx{txOne})))
+	require.Equal(t, types.Txs{txTwo}, mp.ReapMaxNamespaceBytes(append([]byte{0}, namespaceTwo...), -1))
+	require.Empty(t, mp.ReapMaxNamespaceBytes(append([]byte{1}, namespaceOne...), -1))
+
+	// reaping by namespace does not affect the other reaping modes
+	require.Len(t, mp.ReapMaxBytesMaxGas(-1, -1), 4)
+}
+
 // caller must close server
 func newRemoteApp(t *testing.T, addr string, app abci.Application) (abciclient.Client, service.Service) {
 	clientCreator, err := abciclient.NewClient(addr, "socket", true)
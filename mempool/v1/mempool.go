@@ -236,10 +236,11 @@ func (txmp *TxMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo memp
 		return err
 	}
 	wtx := &WrappedTx{
-		tx:        tx,
-		hash:      tx.Key(),
-		timestamp: time.Now().UTC(),
-		height:    height,
+		tx:         tx,
+		hash:       tx.Key(),
+		timestamp:  time.Now().UTC(),
+		height:     height,
+		namespaces: mempool.TxNamespaces(tx),
 	}
 	wtx.SetPeer(txInfo.SenderID)
 	txmp.addNewTransaction(wtx, rsp)
@@ -370,6 +371,31 @@ func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	return keep
 }
 
+// ReapMaxNamespaceBytes returns a slice of valid blob transactions that
+// contain a blob in the given namespace and fit within the size constraint.
+// The results are ordered by nonincreasing priority, with ties broken by
+// increasing order of arrival. Reaping transactions does not remove them from
+// the mempool.
+//
+// If maxBytes < 0, no limit is set on the total size in bytes.
+func (txmp *TxMempool) ReapMaxNamespaceBytes(namespace []byte, maxBytes int64) types.Txs {
+	var totalBytes int64
+
+	var keep []types.Tx //nolint:prealloc
+	for _, w := range txmp.allEntriesSorted() {
+		if !mempool.HasNamespace(w.namespaces, namespace) {
+			continue
+		}
+		txBytes := types.ComputeProtoSizeForTxs([]types.Tx{w.tx})
+		if maxBytes >= 0 && totalBytes+txBytes > maxBytes {
+			continue
+		}
+		totalBytes += txBytes
+		keep = append(keep, w.tx)
+	}
+	return keep
+}
+
 // TxsWaitChan returns a channel that is closed when there is at least one
 // transaction available to be gossiped.
 func (txmp *TxMempool) TxsWaitChan() <-chan struct{} { return txmp.txs.WaitChan() }
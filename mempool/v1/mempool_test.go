@@ -741,6 +741,52 @@ func TestRemoveBlobTx(t *testing.T) {
 	assert.EqualValues(t, 0, txmp.SizeBytes())
 }
 
+func TestReapMaxNamespaceBytes(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+
+	cfg := config.ResetTestRoot(strings.ReplaceAll(t.Name(), "/", "|"))
+	cfg.Mempool.CacheSize = 100
+
+	appConnMem, err := cc.NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+
+	t.Cleanup(func() {
+		os.RemoveAll(cfg.RootDir)
+		require.NoError(t, appConnMem.Stop())
+	})
+
+	txmp := NewTxMempool(log.TestingLogger(), cfg.Mempool, appConnMem, 0)
+
+	namespaceOne := bytes.Repeat([]byte{1}, consts.NamespaceIDSize)
+	namespaceTwo := bytes.Repeat([]byte{2}, consts.NamespaceIDSize)
+	newBlobTx := func(originalTx, namespaceID []byte) types.Tx {
+		bTx, err := types.MarshalBlobTx(originalTx, &tmproto.Blob{
+			NamespaceId: namespaceID,
+			Data:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		})
+		require.NoError(t, err)
+		return bTx
+	}
+
+	txOne := newBlobTx([]byte{1}, namespaceOne)
+	txTwo := newBlobTx([]byte{2}, namespaceTwo)
+	txThree := newBlobTx([]byte{3}, namespaceOne)
+	for _, tx := range []types.Tx{txOne, txTwo, txThree, types.Tx("sender=key=value")} {
+		require.NoError(t, txmp.CheckTx(tx, nil, mempool.TxInfo{}))
+	}
+
+	nsOne := append([]byte{0}, namespaceOne...)
+	require.Equal(t, types.Txs{txOne, txThree}, txmp.ReapMaxNamespaceBytes(nsOne, -1))
+	require.Equal(t, types.Txs{txOne}, txmp.ReapMaxNamespaceBytes(nsOne, types.ComputeProtoSizeForTxs([]types.Tx{txOne})))
+	require.Equal(t, types.Txs{txTwo}, txmp.ReapMaxNamespaceBytes(append([]byte{0}, namespaceTwo...), -1))
+	require.Empty(t, txmp.ReapMaxNamespaceBytes(append([]byte{1}, namespaceOne...), -1))
+
+	// reaping by namespace does not affect the other reaping modes
+	require.Len(t, txmp.ReapMaxBytesMaxGas(-1, -1), 4)
+}
+
 func abciResponses(n int, code uint32) []*abci.ResponseDeliverTx {
 	responses := make([]*abci.ResponseDeliverTx, 0, n)
 	for i := 0; i < n; i++ {
@@ -10,10 +10,11 @@ import (
 // WrappedTx defines a wrapper around a raw transaction with additional metadata
 // that is used for indexing.
 type WrappedTx struct {
-	tx        types.Tx    // the original transaction data
-	hash      types.TxKey // the transaction hash
-	height    int64       // height when this transaction was initially checked (for expiry)
-	timestamp time.Time   // time when transaction was entered (for TTL)
+	tx         types.Tx    // the original transaction data
+	hash       types.TxKey // the transaction hash
+	height     int64       // height when this transaction was initially checked (for expiry)
+	timestamp  time.Time   // time when transaction was entered (for TTL)
+	namespaces [][]byte    // namespaces of the blobs in tx, nil if tx is not a BlobTx
 
 	mtx       sync.Mutex
 	gasWanted int64           // app: gas required to execute this transaction
@@ -129,6 +129,20 @@ func (mr *MockMempoolMockRecorder) ReapMaxBytesMaxGas(maxBytes, maxGas interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapMaxBytesMaxGas", reflect.TypeOf((*MockMempool)(nil).ReapMaxBytesMaxGas), maxBytes, maxGas)
 }
 
+// ReapMaxNamespaceBytes mocks base method.
+func (m *MockMempool) ReapMaxNamespaceBytes(namespace []byte, maxBytes int64) types0.Txs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReapMaxNamespaceBytes", namespace, maxBytes)
+	ret0, _ := ret[0].(types0.Txs)
+	return ret0
+}
+
+// ReapMaxNamespaceBytes indicates an expected call of ReapMaxNamespaceBytes.
+func (mr *MockMempoolMockRecorder) ReapMaxNamespaceBytes(namespace, maxBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapMaxNamespaceBytes", reflect.TypeOf((*MockMempool)(nil).ReapMaxNamespaceBytes), namespace, maxBytes)
+}
+
 // ReapMaxTxs mocks base method.
 func (m *MockMempool) ReapMaxTxs(max int) types0.Txs {
 	m.ctrl.T.Helper()
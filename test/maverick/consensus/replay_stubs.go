@@ -25,6 +25,9 @@ func (emptyMempool) CheckTx(_ types.Tx, _ func(*abci.Response), _ mempl.TxInfo)
 func (emptyMempool) RemoveTxByKey(txKey types.TxKey) error   { return nil }
 func (emptyMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return types.Txs{} }
 func (emptyMempool) ReapMaxTxs(n int) types.Txs              { return types.Txs{} }
+func (emptyMempool) ReapMaxNamespaceBytes(_ []byte, _ int64) types.Txs {
+	return types.Txs{}
+}
 func (emptyMempool) Update(
 	_ int64,
 	_ types.Txs,